@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// DefaultGRPCAddress is the default address the gRPC server listens on.
+	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultGRPCWebAddress is the default address the gRPC-Web server listens on.
+	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultGraphQLAddress is the default address the GraphQL server listens on.
+	DefaultGraphQLAddress = "0.0.0.0:9100"
+)
+
+// BaseConfig defines the server's basic configuration.
+type BaseConfig struct {
+	MinGasPrices string `mapstructure:"minimum-gas-prices"`
+}
+
+// APIConfig defines the API listener configuration.
+type APIConfig struct {
+	Enable  bool   `mapstructure:"enable"`
+	Address string `mapstructure:"address"`
+}
+
+// GRPCConfig defines the gRPC listener configuration.
+type GRPCConfig struct {
+	Enable  bool   `mapstructure:"enable"`
+	Address string `mapstructure:"address"`
+}
+
+// GRPCWebConfig defines the gRPC-Web listener configuration.
+type GRPCWebConfig struct {
+	Enable  bool   `mapstructure:"enable"`
+	Address string `mapstructure:"address"`
+}
+
+// RosettaConfig defines the Rosetta API listener configuration.
+type RosettaConfig struct {
+	Enable     bool   `mapstructure:"enable"`
+	Address    string `mapstructure:"address"`
+	Blockchain string `mapstructure:"blockchain"`
+	Network    string `mapstructure:"network"`
+	Retries    int    `mapstructure:"retries"`
+	Offline    bool   `mapstructure:"offline"`
+}
+
+// GraphQLConfig defines the GraphQL listener configuration. Modules
+// contribute resolvers to the merged schema via the RegisterGraphQLSchema
+// hook; MaxComplexity bounds how many top-level fields that merged schema
+// may expose.
+type GraphQLConfig struct {
+	Enable        bool   `mapstructure:"enable"`
+	Address       string `mapstructure:"address"`
+	Playground    bool   `mapstructure:"playground"`
+	MaxComplexity int    `mapstructure:"max-complexity"`
+}
+
+// TelemetryConfig is an alias of telemetry.Config so that the server config
+// file and telemetry.New share a single definition of the telemetry options.
+type TelemetryConfig = telemetry.Config
+
+// Config defines the server's top-level configuration.
+type Config struct {
+	BaseConfig `mapstructure:",squash"`
+
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
+	API       APIConfig       `mapstructure:"api"`
+	GRPC      GRPCConfig      `mapstructure:"grpc"`
+	GRPCWeb   GRPCWebConfig   `mapstructure:"grpc-web"`
+	Rosetta   RosettaConfig   `mapstructure:"rosetta"`
+	GraphQL   GraphQLConfig   `mapstructure:"graphql"`
+}
+
+// DefaultConfig returns server's default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		BaseConfig: BaseConfig{
+			MinGasPrices: "",
+		},
+		Telemetry: TelemetryConfig{
+			Enabled: false,
+		},
+		API: APIConfig{
+			Enable:  false,
+			Address: "tcp://0.0.0.0:1317",
+		},
+		GRPC: GRPCConfig{
+			Enable:  true,
+			Address: DefaultGRPCAddress,
+		},
+		GRPCWeb: GRPCWebConfig{
+			Enable:  true,
+			Address: DefaultGRPCWebAddress,
+		},
+		Rosetta: RosettaConfig{
+			Enable:     false,
+			Address:    ":8080",
+			Blockchain: "app",
+			Network:    "network",
+			Retries:    3,
+			Offline:    false,
+		},
+		GraphQL: GraphQLConfig{
+			Enable:        false,
+			Address:       DefaultGraphQLAddress,
+			Playground:    false,
+			MaxComplexity: 0,
+		},
+	}
+}
+
+// GetConfig returns a fully parsed Config object, populated from the
+// values bound into v (flags, config file and defaults).
+func GetConfig(v *viper.Viper) Config {
+	conf := DefaultConfig()
+	if err := v.Unmarshal(conf); err != nil {
+		return *DefaultConfig()
+	}
+	return *conf
+}
+
+// ValidateBasic returns an error if GraphQL is enabled with a negative
+// max-complexity. No other field, min-gas-prices included, is checked. It
+// should be called before the server starts.
+func (c Config) ValidateBasic() error {
+	if c.GraphQL.Enable && c.GraphQL.MaxComplexity < 0 {
+		return fmt.Errorf("graphql.max-complexity cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+)
+
+// SchemaBuilder accumulates top-level query fields contributed by modules
+// and produces a single merged GraphQL schema. An application obtains a
+// builder from the server, passes it to every module that implements the
+// GraphQL registration hook, and then calls Build once all modules have had
+// a chance to register their resolvers.
+type SchemaBuilder struct {
+	maxComplexity int
+	fields        graphql.Fields
+}
+
+// NewSchemaBuilder returns an empty SchemaBuilder. maxComplexity bounds the
+// total number of top-level fields a merged schema may expose; it guards
+// against a single deployment accidentally wiring in an unbounded number of
+// expensive resolvers.
+func NewSchemaBuilder(maxComplexity int) *SchemaBuilder {
+	return &SchemaBuilder{
+		maxComplexity: maxComplexity,
+		fields:        graphql.Fields{},
+	}
+}
+
+// AddQueryField registers a top-level query field under name. Modules call
+// this from their RegisterGraphQLSchema hook to contribute resolvers, e.g.
+// one field per query exposed by the module's gRPC query service.
+func (b *SchemaBuilder) AddQueryField(name string, field *graphql.Field) error {
+	if _, exists := b.fields[name]; exists {
+		return fmt.Errorf("graphql query field %q already registered", name)
+	}
+	if b.maxComplexity > 0 && len(b.fields)+1 > b.maxComplexity {
+		return fmt.Errorf("graphql schema exceeds max complexity of %d fields", b.maxComplexity)
+	}
+
+	b.fields[name] = field
+	return nil
+}
+
+// Build merges all registered query fields into a single executable schema.
+func (b *SchemaBuilder) Build() (graphql.Schema, error) {
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: b.fields,
+		}),
+	})
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/handler"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Config holds the parameters needed to start a GraphQL Server.
+type Config struct {
+	Address       string
+	Playground    bool
+	MaxComplexity int
+}
+
+// Server serves a merged GraphQL schema over HTTP, with an optional
+// GraphiQL/Playground UI mounted at /graphql/playground.
+type Server struct {
+	logger  log.Logger
+	httpSrv *http.Server
+}
+
+// New builds a Server that exposes schema at "/graphql", and additionally
+// at "/graphql/playground" via an interactive GraphiQL UI when cfg.Playground
+// is set.
+func New(schema graphql.Schema, cfg Config, logger log.Logger) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", handler.New(&handler.Config{
+		Schema: &schema,
+		Pretty: true,
+	}))
+
+	if cfg.Playground {
+		mux.Handle("/graphql/playground", handler.New(&handler.Config{
+			Schema:   &schema,
+			GraphiQL: true,
+		}))
+	}
+
+	return &Server{
+		logger: logger,
+		httpSrv: &http.Server{
+			Addr:    cfg.Address,
+			Handler: mux,
+		},
+	}
+}
+
+// Start runs the GraphQL HTTP server. It blocks until the server is closed
+// or fails to (re)start, mirroring api.Server.Start.
+func (s *Server) Start() error {
+	s.logger.Info("starting graphql server", "address", s.httpSrv.Addr)
+	if err := s.httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Close gracefully stops the GraphQL HTTP server.
+func (s *Server) Close() error {
+	return s.httpSrv.Close()
+}
@@ -3,19 +3,30 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime/pprof"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
 	abciclient "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmservice "github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/node"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	"github.com/tendermint/tendermint/rpc/client/local"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
@@ -26,11 +37,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	servergraphql "github.com/cosmos/cosmos-sdk/server/graphql"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
-	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
@@ -61,6 +73,7 @@ const (
 	flagGRPCAddress    = "grpc.address"
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
+	flagGRPCOnly       = "grpc-only"
 )
 
 // State sync-related flags.
@@ -69,6 +82,19 @@ const (
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
+// GraphQL-related flags.
+const (
+	flagGraphQLEnable        = "graphql.enable"
+	flagGraphQLAddress       = "graphql.address"
+	flagGraphQLPlayground    = "graphql.playground"
+	flagGraphQLMaxComplexity = "graphql.max-complexity"
+)
+
+// Telemetry-related flags.
+const (
+	flagTelemetryAddress = "telemetry.address"
+)
+
 // StartCmd runs the service passed in, either stand-alone or in-process with
 // Tendermint.
 func StartCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
@@ -96,6 +122,22 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+The node may be started in a query-only mode via the '--grpc-only' flag. In this mode Tendermint
+consensus, mempool and p2p are not started; the process instead connects to the Tendermint RPC
+endpoint configured via '--node' (defaulting to 'tcp://localhost:26657') and serves the gRPC,
+gRPC-Web, API gateway and Rosetta servers against a local, read-only copy of application state.
+This is useful for horizontally scaling query traffic behind a single consensus node.
+
+A GraphQL query server can be enabled alongside the gRPC-gateway via the '--graphql.enable' flag.
+Modules contribute resolvers by implementing a RegisterGraphQLSchema hook; the node merges them
+into a single schema served at '/graphql', with an optional GraphiQL UI at '/graphql/playground'.
+
+When telemetry is enabled ('telemetry.enabled' in app.toml) and the API server is disabled, a
+dedicated Prometheus metrics server is started on the address given by '--telemetry.address',
+exposing '/metrics' on its own. This allows Prometheus scraping in deployments that have no use
+for the API server, e.g. '--grpc-only' or validator-only nodes. When the API server is enabled it
+already serves '/metrics' itself, so the dedicated server is not started a second time.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -115,12 +157,17 @@ which accepts a path for the resulting pprof file.
 			}
 
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
-			if !withTM {
+			grpcOnly, _ := cmd.Flags().GetBool(flagGRPCOnly)
+			if !withTM && !grpcOnly {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
-				return startStandAlone(serverCtx, appCreator)
+				return startStandAlone(serverCtx, clientCtx, appCreator)
 			}
 
-			serverCtx.Logger.Info("starting ABCI with Tendermint")
+			if grpcOnly {
+				serverCtx.Logger.Info("starting node in query-only mode; Tendermint consensus, mempool and p2p are disabled")
+			} else {
+				serverCtx.Logger.Info("starting ABCI with Tendermint")
+			}
 
 			// amino is needed here for backwards compatibility of REST routes
 			err = startInProcess(serverCtx, clientCtx, appCreator)
@@ -158,15 +205,31 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
 	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
 
+	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in query-only mode, skipping Tendermint and serving queries against a remote Tendermint RPC endpoint (see --node)")
+
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
+	cmd.Flags().Bool(flagGraphQLEnable, false, "Define if the GraphQL server should be enabled")
+	cmd.Flags().String(flagGraphQLAddress, "0.0.0.0:9100", "The GraphQL server address to listen on")
+	cmd.Flags().Bool(flagGraphQLPlayground, false, "Serve a GraphiQL playground UI at /graphql/playground")
+	cmd.Flags().Int(flagGraphQLMaxComplexity, 0, "Maximum number of top-level fields a merged GraphQL schema may expose (0 means unlimited)")
+
+	cmd.Flags().String(flagTelemetryAddress, "0.0.0.0:7101", "The address the dedicated Prometheus telemetry server listens on, when telemetry is enabled")
+
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
 }
 
-func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
+// startStandAlone runs a bare ABCI application against an external
+// consensus engine (no Tendermint in-process). Snapshot configuration is
+// already shared with startInProcess via appCreator, which reads the same
+// state-sync flags off ctx.Viper; here we additionally bring the app's
+// query surface up to parity by wiring the gRPC query server, gRPC-Web, API
+// gateway and an offline Rosetta server against the local app instance,
+// whenever the corresponding config.*.Enable flags are set.
+func startStandAlone(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) (err error) {
 	addr := ctx.Viper.GetString(flagAddress)
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
@@ -182,6 +245,11 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		return err
 	}
 
+	config := config.GetConfig(ctx.Viper)
+	if err := config.ValidateBasic(); err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	svr, err := server.NewServer(addr, transport, app)
@@ -191,23 +259,135 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 
 	svr.SetLogger(ctx.Logger.With("module", "abci-server"))
 
-	err = svr.Start()
-	if err != nil {
+	if err := svr.Start(); err != nil {
 		tmos.Exit(err.Error())
 	}
 
+	gCtx, cancelFn := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	g, gCtx := errgroup.WithContext(gCtx)
+
+	// Cancelling gCtx only signals the shutdown goroutines below to start
+	// tearing down already-started subsystems; it does not wait for them to
+	// finish. Every return path, not just the success path, must wait on g
+	// so an early setup error can't leave the ABCI server/gRPC/API
+	// mid-shutdown when this function returns to its caller.
 	defer func() {
-		if err = svr.Stop(); err != nil {
-			tmos.Exit(err.Error())
+		cancelFn()
+		waitErr := g.Wait()
+		ctx.Logger.Info("exiting...")
+		if err == nil {
+			err = waitErr
 		}
 	}()
 
-	// Wait for SIGINT or SIGTERM signal
-	return WaitForQuitSignals()
+	g.Go(func() error {
+		<-gCtx.Done()
+		ctx.Logger.Info("stopping abci server")
+		return svr.Stop()
+	})
+
+	clientCtx = clientCtx.WithHomeDir(home)
+
+	if config.GRPC.Enable {
+		grpcSrv, err := servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		if err != nil {
+			return err
+		}
+
+		var grpcWebSrv *http.Server
+		if config.GRPCWeb.Enable {
+			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
+			if err != nil {
+				ctx.Logger.Error("failed to start grpc-web http server: ", err)
+				return err
+			}
+		}
+
+		g.Go(func() error {
+			<-gCtx.Done()
+			ctx.Logger.Info("stopping grpc server")
+			grpcSrv.Stop()
+			if grpcWebSrv != nil {
+				grpcWebSrv.Close()
+			}
+			return nil
+		})
+	}
+
+	if config.API.Enable {
+		apiClientCtx := clientCtx
+
+		if config.GRPC.Enable {
+			_, port, err := net.SplitHostPort(config.GRPC.Address)
+			if err != nil {
+				return err
+			}
+			grpcAddress := fmt.Sprintf("127.0.0.1:%s", port)
+			// If grpc is enabled, configure grpc client for grpc gateway.
+			grpcClient, err := grpc.Dial(
+				grpcAddress,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(apiClientCtx.InterfaceRegistry).GRPCCodec())),
+			)
+			if err != nil {
+				return err
+			}
+			apiClientCtx = apiClientCtx.WithGRPCClient(grpcClient)
+			ctx.Logger.Debug("grpc client assigned to client context", "target", grpcAddress)
+		}
+
+		apiSrv := api.New(apiClientCtx, ctx.Logger.With("module", "api-server"))
+		app.RegisterAPIRoutes(apiSrv, config.API)
+
+		startAPI := func() error { return apiSrv.Start(config) }
+		if err := startSupervisedServer(gCtx, g, ctx.Logger, "api", startAPI, apiSrv.Close); err != nil {
+			return err
+		}
+	}
+
+	if config.Rosetta.Enable {
+		// A stand-alone ABCI node has no Tendermint RPC endpoint of its own
+		// to query, so Rosetta can only ever run in offline mode here.
+		conf := &rosetta.Config{
+			Blockchain:   config.Rosetta.Blockchain,
+			Network:      config.Rosetta.Network,
+			GRPCEndpoint: config.GRPC.Address,
+			Addr:         config.Rosetta.Address,
+			Retries:      config.Rosetta.Retries,
+			Offline:      true,
+		}
+		conf.WithCodec(clientCtx.InterfaceRegistry, clientCtx.Codec.(*codec.ProtoCodec))
+
+		rosettaSrv, err := rosetta.ServerFromConfig(conf)
+		if err != nil {
+			return err
+		}
+
+		if err := startSupervisedServer(gCtx, g, ctx.Logger, "rosetta", rosettaSrv.Start, rosettaSrv.Close); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger.Info("all requested servers started")
+
+	// Block here, not in the deferred cleanup, so cancelFn only fires once a
+	// quit signal arrives or a supervised goroutine reports an error
+	// (errgroup cancels gCtx itself in that case), never the instant the
+	// setup above finishes.
+	<-gCtx.Done()
+
+	return nil
+}
+
+// graphQLApplication is implemented by applications that contribute
+// resolvers to the merged GraphQL schema. It is optional: an app that does
+// not implement it simply serves no GraphQL fields.
+type graphQLApplication interface {
+	RegisterGraphQLSchema(b *servergraphql.SchemaBuilder) error
 }
 
 // legacyAminoCdc is used for the legacy REST API
-func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
+func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) (err error) {
 	cfg := ctx.Config
 	home := cfg.RootDir
 	var cpuProfileCleanup func()
@@ -248,53 +428,135 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
-	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
-	if err != nil {
-		return err
-	}
-
-	tmNode, err := node.New(
-		cfg,
-		ctx.Logger,
-		abciclient.NewLocalCreator(app),
-		genDoc,
-	)
-	if err != nil {
-		return err
-	}
+	grpcOnly := ctx.Viper.GetBool(flagGRPCOnly)
 
-	ctx.Logger.Debug("initialization: tmNode created")
-	if err := tmNode.Start(); err != nil {
-		return err
+	// Phase 1: start Tendermint in-process, unless the node is running in
+	// query-only (--grpc-only) mode, in which case consensus, mempool and
+	// p2p are never started and we instead dial an external Tendermint RPC
+	// endpoint below.
+	var tmNode tmservice.Service
+	if !grpcOnly {
+		tmNode, err = startTendermint(cfg, ctx, app)
+		if err != nil {
+			return err
+		}
+	} else {
+		ctx.Logger.Info("node is running in query-only mode; connecting to remote Tendermint RPC", "node", clientCtx.NodeURI)
 	}
-	ctx.Logger.Debug("initialization: tmNode started")
 
-	// Add the tx service to the gRPC router. We only need to register this
-	// service if API or gRPC is enabled, and avoid doing so in the general
-	// case, because it spawns a new local tendermint RPC client.
+	// Phase 2: start the app-bound gRPC services. Add the tx and Tendermint
+	// services to the gRPC router, backed by a local Tendermint RPC client
+	// when Tendermint is running in-process, or a remote client when running
+	// in query-only mode. We only need to register these services if API or
+	// gRPC is enabled, and avoid doing so in the general case, because it
+	// spawns a new Tendermint RPC client.
 	if config.API.Enable || config.GRPC.Enable {
-		node, ok := tmNode.(local.NodeService)
-		if !ok {
-			panic("unable to set node type. Please try reinstalling the binary.")
-		}
-		localNode, err := local.New(node)
+		tmRPC, err := getTendermintClient(tmNode, clientCtx.NodeURI)
 		if err != nil {
-			panic(err)
+			return err
 		}
-		clientCtx = clientCtx.WithClient(localNode)
+		clientCtx = clientCtx.WithClient(tmRPC)
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
 	}
 
-	var apiSrv *api.Server
+	// The remaining sub-servers are started and stopped through a single
+	// supervisor: g.Go'd goroutines run their blocking Start calls, and a
+	// shutdown goroutine per server waits on gCtx (cancelled on SIGINT or
+	// SIGTERM) and tears it down. Servers started through
+	// startSupervisedServer are given a bounded timeout to stop; the
+	// Tendermint node and the gRPC servers are stopped without one. This replaces the
+	// previous defer-based teardown, which silently dropped errors from
+	// Close/Stop and could leak goroutines if one server failed to start
+	// after another had already come up.
+	gCtx, cancelFn := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	g, gCtx := errgroup.WithContext(gCtx)
+
+	// Cancelling gCtx only signals the shutdown goroutines below to start
+	// tearing down already-started subsystems; it does not wait for them to
+	// finish. Every return path, not just the success path, must wait on g
+	// so an early setup error can't leave Tendermint/gRPC/API/telemetry
+	// mid-shutdown when this function returns to its caller.
+	defer func() {
+		cancelFn()
+		waitErr := g.Wait()
+		ctx.Logger.Info("exiting...")
+		if err == nil {
+			err = waitErr
+		}
+	}()
+
+	if cpuProfileCleanup != nil {
+		g.Go(func() error {
+			<-gCtx.Done()
+			cpuProfileCleanup()
+			return nil
+		})
+	}
+
+	if tmNode != nil {
+		g.Go(func() error {
+			<-gCtx.Done()
+			ctx.Logger.Info("stopping tendermint node")
+			return tmNode.Stop()
+		})
+	}
+
+	// api.Server.Start already calls telemetry.New(config.Telemetry) and
+	// serves "/metrics" itself when telemetry is enabled, and telemetry.New
+	// registers against hashicorp go-metrics' global sink and a shared
+	// Prometheus registry. A second call here in the same process would
+	// race to re-register those same counters/gauges, so the dedicated
+	// telemetry server below only stands up its own instance when the API
+	// server isn't already going to own one.
+	if config.Telemetry.Enabled && !config.API.Enable {
+		metrics, err := telemetry.New(config.Telemetry)
+		if err != nil {
+			return err
+		}
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+			format := strings.TrimSpace(r.FormValue("format"))
+
+			gr, err := metrics.Gather(format)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to gather metrics: %s", err), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", gr.ContentType)
+			_, _ = w.Write(gr.Metrics)
+		})
+
+		telemetrySrv := &http.Server{
+			// telemetry.Config has no listen-address field upstream (it only
+			// configures the metrics sink itself), so the dedicated server's
+			// address stays sourced from viper rather than the config file.
+			Addr:    ctx.Viper.GetString(flagTelemetryAddress),
+			Handler: mux,
+		}
+
+		startTelemetry := func() error {
+			if err := telemetrySrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		}
+
+		if err := startSupervisedServer(gCtx, g, ctx.Logger, "telemetry", startTelemetry, telemetrySrv.Close); err != nil {
+			return err
+		}
+	}
+
 	if config.API.Enable {
 		genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
 		if err != nil {
 			return err
 		}
 
-		clientCtx := clientCtx.
+		apiClientCtx := clientCtx.
 			WithHomeDir(home).
 			WithChainID(genDoc.ChainID)
 
@@ -308,41 +570,31 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			grpcClient, err := grpc.Dial(
 				grpcAddress,
 				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec())),
+				grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(apiClientCtx.InterfaceRegistry).GRPCCodec())),
 			)
 			if err != nil {
 				return err
 			}
-			clientCtx = clientCtx.WithGRPCClient(grpcClient)
+			apiClientCtx = apiClientCtx.WithGRPCClient(grpcClient)
 			ctx.Logger.Debug("grpc client assigned to client context", "target", grpcAddress)
 		}
 
-		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		apiSrv := api.New(apiClientCtx, ctx.Logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, config.API)
-		errCh := make(chan error)
-
-		go func() {
-			if err := apiSrv.Start(config); err != nil {
-				errCh <- err
-			}
-		}()
 
-		select {
-		case err := <-errCh:
+		startAPI := func() error { return apiSrv.Start(config) }
+		if err := startSupervisedServer(gCtx, g, ctx.Logger, "api", startAPI, apiSrv.Close); err != nil {
 			return err
-		case <-time.After(types.ServerStartTime): // assume server started successfully
 		}
 	}
 
-	var (
-		grpcSrv    *grpc.Server
-		grpcWebSrv *http.Server
-	)
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, err := servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
 		if err != nil {
 			return err
 		}
+
+		var grpcWebSrv *http.Server
 		if config.GRPCWeb.Enable {
 			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
 			if err != nil {
@@ -350,19 +602,62 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 				return err
 			}
 		}
+
+		g.Go(func() error {
+			<-gCtx.Done()
+			ctx.Logger.Info("stopping grpc server")
+			grpcSrv.Stop()
+			if grpcWebSrv != nil {
+				grpcWebSrv.Close()
+			}
+			return nil
+		})
+	}
+
+	if config.GraphQL.Enable {
+		gqlApp, ok := app.(graphQLApplication)
+		if !ok {
+			ctx.Logger.Info("graphql enabled but application does not implement RegisterGraphQLSchema; skipping")
+		} else {
+			builder := servergraphql.NewSchemaBuilder(config.GraphQL.MaxComplexity)
+			if err := gqlApp.RegisterGraphQLSchema(builder); err != nil {
+				return err
+			}
+
+			schema, err := builder.Build()
+			if err != nil {
+				return err
+			}
+
+			graphQLSrv := servergraphql.New(schema, servergraphql.Config{
+				Address:       config.GraphQL.Address,
+				Playground:    config.GraphQL.Playground,
+				MaxComplexity: config.GraphQL.MaxComplexity,
+			}, ctx.Logger.With("module", "graphql-server"))
+
+			if err := startSupervisedServer(gCtx, g, ctx.Logger, "graphql", graphQLSrv.Start, graphQLSrv.Close); err != nil {
+				return err
+			}
+		}
 	}
 
-	var rosettaSrv crgserver.Server
 	if config.Rosetta.Enable {
 		offlineMode := config.Rosetta.Offline
 		if !config.GRPC.Enable { // If GRPC is not enabled rosetta cannot work in online mode, so it works in offline mode.
 			offlineMode = true
 		}
 
+		tendermintRPC := ctx.Config.RPC.ListenAddress
+		if grpcOnly {
+			// Tendermint is not running in-process; point Rosetta at the
+			// remote RPC endpoint instead of the local listen address.
+			tendermintRPC = clientCtx.NodeURI
+		}
+
 		conf := &rosetta.Config{
 			Blockchain:    config.Rosetta.Blockchain,
 			Network:       config.Rosetta.Network,
-			TendermintRPC: ctx.Config.RPC.ListenAddress,
+			TendermintRPC: tendermintRPC,
 			GRPCEndpoint:  config.GRPC.Address,
 			Addr:          config.Rosetta.Address,
 			Retries:       config.Rosetta.Retries,
@@ -370,47 +665,119 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 		conf.WithCodec(clientCtx.InterfaceRegistry, clientCtx.Codec.(*codec.ProtoCodec))
 
-		rosettaSrv, err = rosetta.ServerFromConfig(conf)
+		rosettaSrv, err := rosetta.ServerFromConfig(conf)
 		if err != nil {
 			return err
 		}
-		errCh := make(chan error)
-		go func() {
-			if err := rosettaSrv.Start(); err != nil {
-				errCh <- err
-			}
-		}()
 
-		select {
-		case err := <-errCh:
+		if err := startSupervisedServer(gCtx, g, ctx.Logger, "rosetta", rosettaSrv.Start, rosettaSrv.Close); err != nil {
 			return err
-		case <-time.After(types.ServerStartTime): // assume server started successfully
 		}
 	}
 
-	defer func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
-		}
+	ctx.Logger.Info("all requested servers started")
 
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
-		}
+	// Block here, not in the deferred cleanup, so cancelFn only fires once a
+	// quit signal arrives or a supervised goroutine reports an error
+	// (errgroup cancels gCtx itself in that case), never the instant the
+	// setup above finishes. The deferred wait then blocks until every
+	// shutdown goroutine has actually finished tearing its subsystem down.
+	<-gCtx.Done()
+
+	return nil
+}
 
-		if apiSrv != nil {
-			_ = apiSrv.Close()
+// serverShutdownTimeout bounds how long a supervised sub-server is given to
+// shut down once a quit signal is received, before startInProcess gives up
+// waiting on it and reports an error.
+const serverShutdownTimeout = 5 * time.Second
+
+// startSupervisedServer launches a blocking server Start function inside the
+// supervisor group and arranges for stop to be invoked, with a bounded
+// timeout, once gCtx is cancelled. It returns once the server has had a
+// chance to report an immediate startup failure, bounded by
+// types.ServerStartTime; this is the same "assume it started" grace period
+// used historically, but errors discovered afterwards now surface through
+// the group instead of being dropped.
+func startSupervisedServer(gCtx context.Context, g *errgroup.Group, logger tmlog.Logger, name string, start func() error, stop func() error) error {
+	startErrCh := make(chan error, 1)
+
+	g.Go(func() error {
+		err := start()
+		startErrCh <- err
+		if err != nil {
+			return fmt.Errorf("%s server: %w", name, err)
 		}
+		return nil
+	})
 
-		if grpcSrv != nil {
-			grpcSrv.Stop()
-			if grpcWebSrv != nil {
-				grpcWebSrv.Close()
-			}
+	g.Go(func() error {
+		<-gCtx.Done()
+		logger.Info(fmt.Sprintf("stopping %s server", name))
+
+		stopCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+
+		stopped := make(chan error, 1)
+		go func() { stopped <- stop() }()
+
+		select {
+		case err := <-stopped:
+			return err
+		case <-stopCtx.Done():
+			return fmt.Errorf("%s server did not shut down within %s", name, serverShutdownTimeout)
 		}
+	})
 
-		ctx.Logger.Info("exiting...")
-	}()
+	select {
+	case err := <-startErrCh:
+		return err
+	case <-time.After(types.ServerStartTime): // assume server started successfully
+		return nil
+	}
+}
+
+// startTendermint constructs and starts a Tendermint node running the given
+// ABCI application in-process.
+func startTendermint(cfg *tmcfg.Config, ctx *Context, app types.Application) (tmservice.Service, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+
+	tmNode, err := node.New(
+		cfg,
+		ctx.Logger,
+		abciclient.NewLocalCreator(app),
+		genDoc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug("initialization: tmNode created")
+	if err := tmNode.Start(); err != nil {
+		return nil, err
+	}
+	ctx.Logger.Debug("initialization: tmNode started")
+
+	return tmNode, nil
+}
+
+// getTendermintClient returns a Tendermint RPC client to back the tx and
+// Tendermint gRPC services. When tmNode is non-nil (Tendermint is running
+// in-process) it returns a local client talking directly to the node;
+// otherwise it dials the remote RPC endpoint configured via nodeURI, which
+// is how a query-only (--grpc-only) node serves these services.
+func getTendermintClient(tmNode tmservice.Service, nodeURI string) (rpcclient.Client, error) {
+	if tmNode == nil {
+		return rpchttp.New(nodeURI, "/websocket")
+	}
+
+	node, ok := tmNode.(local.NodeService)
+	if !ok {
+		panic("unable to set node type. Please try reinstalling the binary.")
+	}
 
-	// Wait for SIGINT or SIGTERM signal
-	return WaitForQuitSignals()
+	return local.New(node)
 }